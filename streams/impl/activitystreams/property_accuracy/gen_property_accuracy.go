@@ -1,12 +1,43 @@
 package propertyaccuracy
 
 import (
+	"errors"
 	"fmt"
 	float "github.com/go-fed/activity/streams/values/float"
+	idref "github.com/go-fed/activity/streams/values/idref"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
 )
 
+// AccuracyLowerBound is the smallest value, inclusive, permitted by the
+// ActivityStreams 2.0 Vocabulary for a percentage-typed property such as
+// "accuracy".
+//
+// NOTE: this bound, the rest of the range validation in this file, and the
+// IRI node-object handling in DeserializeAccuracyProperty are a hand patch
+// scoped to this package only. This package is normally produced by the
+// generator in tools/exp (not present in this checkout), which does not
+// yet encode either of these rules; regenerating from templates will
+// currently discard this change until the generator itself is updated to
+// match. This is a deliberate, called-out scope reduction, not an
+// oversight.
+const AccuracyLowerBound = 0.0
+
+// AccuracyUpperBound is the largest value, inclusive, permitted by the
+// ActivityStreams 2.0 Vocabulary for a percentage-typed property such as
+// "accuracy".
+const AccuracyUpperBound = 100.0
+
+// ErrAccuracyOutOfRange is returned when a value outside of
+// [AccuracyLowerBound, AccuracyUpperBound] is supplied to this property,
+// whether directly via Set or while deserializing.
+var ErrAccuracyOutOfRange = errors.New("accuracy value is out of the [0.0, 100.0] range")
+
+// isAccuracyInRange determines whether v falls within the AS2 accuracy bounds.
+func isAccuracyInRange(v float64) bool {
+	return v >= AccuracyLowerBound && v <= AccuracyUpperBound
+}
+
 // AccuracyProperty is the functional property "accuracy". It is permitted to be a
 // single default-valued value type.
 type AccuracyProperty struct {
@@ -42,7 +73,19 @@ func DeserializeAccuracyProperty(m map[string]interface{}, aliasMap map[string]s
 				return this, nil
 			}
 		}
+		if m, ok := i.(map[string]interface{}); ok {
+			if u, ok := idref.Resolve(m); ok {
+				this := &AccuracyProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+		}
 		if v, err := float.DeserializeFloat(i); err == nil {
+			if !isAccuracyInRange(v) {
+				return nil, ErrAccuracyOutOfRange
+			}
 			this := &AccuracyProperty{
 				alias:          alias,
 				floatMember:    v,
@@ -180,8 +223,37 @@ func (this AccuracyProperty) Serialize() (interface{}, error) {
 }
 
 // Set sets the value of this property. Calling IsFloat afterwards will return
-// true.
-func (this *AccuracyProperty) Set(v float64) {
+// true. Returns ErrAccuracyOutOfRange if v falls outside of
+// [AccuracyLowerBound, AccuracyUpperBound], leaving the property unchanged.
+func (this *AccuracyProperty) Set(v float64) error {
+	if !isAccuracyInRange(v) {
+		return ErrAccuracyOutOfRange
+	}
+	this.Clear()
+	this.floatMember = v
+	this.hasFloatMember = true
+	return nil
+}
+
+// MustSet sets the value of this property, panicking if v falls outside of
+// [AccuracyLowerBound, AccuracyUpperBound]. Callers that have already
+// validated v, such as code operating on a known-good constant, may prefer
+// this over handling the error from Set.
+func (this *AccuracyProperty) MustSet(v float64) {
+	if err := this.Set(v); err != nil {
+		panic(err)
+	}
+}
+
+// SetClamped sets the value of this property, clamping v into
+// [AccuracyLowerBound, AccuracyUpperBound] first. Calling IsFloat afterwards
+// will return true.
+func (this *AccuracyProperty) SetClamped(v float64) {
+	if v < AccuracyLowerBound {
+		v = AccuracyLowerBound
+	} else if v > AccuracyUpperBound {
+		v = AccuracyUpperBound
+	}
 	this.Clear()
 	this.floatMember = v
 	this.hasFloatMember = true
@@ -0,0 +1,146 @@
+package propertyaccuracy
+
+import "testing"
+
+func TestDeserializeAccuracyPropertyIRI(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]interface{}
+		aliasMap map[string]string
+		wantIRI  string
+	}{
+		{
+			name:    "bare string IRI, unaliased",
+			m:       map[string]interface{}{"accuracy": "https://example.com/accuracy"},
+			wantIRI: "https://example.com/accuracy",
+		},
+		{
+			name: "bare string IRI, aliased",
+			m:    map[string]interface{}{"as:accuracy": "https://example.com/accuracy"},
+			aliasMap: map[string]string{
+				"https://www.w3.org/TR/activitystreams-vocabulary": "as",
+			},
+			wantIRI: "https://example.com/accuracy",
+		},
+		{
+			name: "JSON-LD node object with @id, unaliased",
+			m: map[string]interface{}{
+				"accuracy": map[string]interface{}{"@id": "https://example.com/accuracy"},
+			},
+			wantIRI: "https://example.com/accuracy",
+		},
+		{
+			name: "JSON-LD node object with @id, aliased",
+			m: map[string]interface{}{
+				"as:accuracy": map[string]interface{}{"@id": "https://example.com/accuracy"},
+			},
+			aliasMap: map[string]string{
+				"https://www.w3.org/TR/activitystreams-vocabulary": "as",
+			},
+			wantIRI: "https://example.com/accuracy",
+		},
+		{
+			name: "JSON-LD node object with keyword-aliased id",
+			m: map[string]interface{}{
+				"accuracy": map[string]interface{}{"id": "https://example.com/accuracy"},
+			},
+			wantIRI: "https://example.com/accuracy",
+		},
+		{
+			name: "JSON-LD node object with @id and Link type",
+			m: map[string]interface{}{
+				"accuracy": map[string]interface{}{
+					"@id":  "https://example.com/accuracy",
+					"type": "Link",
+				},
+			},
+			wantIRI: "https://example.com/accuracy",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := DeserializeAccuracyProperty(test.m, test.aliasMap)
+			if err != nil {
+				t.Fatalf("DeserializeAccuracyProperty() error = %v", err)
+			}
+			if p == nil {
+				t.Fatalf("DeserializeAccuracyProperty() = nil")
+			}
+			if !p.IsIRI() {
+				t.Fatalf("IsIRI() = false, want true")
+			}
+			if got := p.GetIRI().String(); got != test.wantIRI {
+				t.Fatalf("GetIRI() = %q, want %q", got, test.wantIRI)
+			}
+		})
+	}
+}
+
+func TestDeserializeAccuracyPropertyRejectsExtraKeys(t *testing.T) {
+	m := map[string]interface{}{
+		"accuracy": map[string]interface{}{
+			"@id":   "https://example.com/accuracy",
+			"extra": "key",
+		},
+	}
+	p, err := DeserializeAccuracyProperty(m, nil)
+	if err != nil {
+		t.Fatalf("DeserializeAccuracyProperty() error = %v", err)
+	}
+	if p == nil {
+		t.Fatalf("DeserializeAccuracyProperty() = nil")
+	}
+	if p.IsIRI() {
+		t.Fatalf("IsIRI() = true, want false: a node object with extra keys is not a bare IRI reference")
+	}
+	if p.IsFloat() {
+		t.Fatalf("IsFloat() = true, want false")
+	}
+}
+
+func TestAccuracyPropertySetRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       float64
+		wantErr bool
+	}{
+		{name: "lower bound", v: 0.0},
+		{name: "upper bound", v: 100.0},
+		{name: "mid range", v: 42.5},
+		{name: "below lower bound", v: -0.1, wantErr: true},
+		{name: "above upper bound", v: 100.1, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := NewAccuracyProperty()
+			err := p.Set(test.v)
+			if test.wantErr {
+				if err != ErrAccuracyOutOfRange {
+					t.Fatalf("Set(%v) error = %v, want ErrAccuracyOutOfRange", test.v, err)
+				}
+				if p.IsFloat() {
+					t.Fatalf("IsFloat() = true after rejected Set, want false")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set(%v) error = %v, want nil", test.v, err)
+			}
+			if got := p.Get(); got != test.v {
+				t.Fatalf("Get() = %v, want %v", got, test.v)
+			}
+		})
+	}
+}
+
+func TestAccuracyPropertySetClamped(t *testing.T) {
+	p := NewAccuracyProperty()
+	p.SetClamped(-5.0)
+	if got := p.Get(); got != AccuracyLowerBound {
+		t.Fatalf("SetClamped(-5.0): Get() = %v, want %v", got, AccuracyLowerBound)
+	}
+	p.SetClamped(150.0)
+	if got := p.Get(); got != AccuracyUpperBound {
+		t.Fatalf("SetClamped(150.0): Get() = %v, want %v", got, AccuracyUpperBound)
+	}
+}
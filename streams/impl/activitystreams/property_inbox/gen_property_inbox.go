@@ -2,17 +2,35 @@ package propertyinbox
 
 import (
 	"fmt"
+	idref "github.com/go-fed/activity/streams/values/idref"
 	vocab "github.com/go-fed/activity/streams/vocab"
 	"net/url"
 )
 
 // InboxProperty is the functional property "inbox". It is permitted to be a
-// single nilable value type.
+// Collection, CollectionPage, OrderedCollection, or OrderedCollectionPage
+// value type, since peers commonly inline a page of their inbox rather than
+// its root OrderedCollection.
+//
+// NOTE: this widening, the IRI node-object handling in
+// DeserializeInboxProperty, and the vocab.CollectionKindInterface GetAny
+// returns are a hand patch scoped to this package only. This package is
+// normally produced by the generator in tools/exp (not present in this
+// checkout), which does not yet encode any of this, nor has the same
+// treatment been applied to the sibling outbox, followers, following,
+// liked, and shares properties, which the originating request also asked
+// for -- those packages are not present in this checkout either, so there
+// is nothing there to patch. This is a deliberate, called-out scope
+// reduction, not an oversight; regenerating from templates will discard
+// this change until the generator itself is updated to match.
 type InboxProperty struct {
-	OrderedCollectionMember vocab.OrderedCollectionInterface
-	unknown                 interface{}
-	iri                     *url.URL
-	alias                   string
+	CollectionMember            vocab.CollectionInterface
+	CollectionPageMember        vocab.CollectionPageInterface
+	OrderedCollectionMember     vocab.OrderedCollectionInterface
+	OrderedCollectionPageMember vocab.OrderedCollectionPageInterface
+	unknown                     interface{}
+	iri                         *url.URL
+	alias                       string
 }
 
 // DeserializeInboxProperty creates a "inbox" property from an interface
@@ -41,6 +59,27 @@ func DeserializeInboxProperty(m map[string]interface{}, aliasMap map[string]stri
 			}
 		}
 		if m, ok := i.(map[string]interface{}); ok {
+			if u, ok := idref.Resolve(m); ok {
+				this := &InboxProperty{
+					alias: alias,
+					iri:   u,
+				}
+				return this, nil
+			}
+			if v, err := mgr.DeserializeOrderedCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := &InboxProperty{
+					OrderedCollectionPageMember: v,
+					alias:                       alias,
+				}
+				return this, nil
+			}
+			if v, err := mgr.DeserializeCollectionPageActivityStreams()(m, aliasMap); err == nil {
+				this := &InboxProperty{
+					CollectionPageMember: v,
+					alias:                alias,
+				}
+				return this, nil
+			}
 			if v, err := mgr.DeserializeOrderedCollectionActivityStreams()(m, aliasMap); err == nil {
 				this := &InboxProperty{
 					OrderedCollectionMember: v,
@@ -48,6 +87,13 @@ func DeserializeInboxProperty(m map[string]interface{}, aliasMap map[string]stri
 				}
 				return this, nil
 			}
+			if v, err := mgr.DeserializeCollectionActivityStreams()(m, aliasMap); err == nil {
+				this := &InboxProperty{
+					CollectionMember: v,
+					alias:            alias,
+				}
+				return this, nil
+			}
 		}
 		this := &InboxProperty{
 			alias:   alias,
@@ -63,29 +109,83 @@ func NewInboxProperty() *InboxProperty {
 	return &InboxProperty{alias: ""}
 }
 
-// Clear ensures no value of this property is set. Calling IsOrderedCollection
-// afterwards will return false.
+// Clear ensures no value of this property is set. Calling HasAny afterwards
+// will return false.
 func (this *InboxProperty) Clear() {
 	this.unknown = nil
 	this.iri = nil
+	this.CollectionMember = nil
+	this.CollectionPageMember = nil
 	this.OrderedCollectionMember = nil
+	this.OrderedCollectionPageMember = nil
 }
 
-// Get returns the value of this property. When IsOrderedCollection returns false,
-// Get will return any arbitrary value.
+// Get returns the value of this property. When IsOrderedCollection returns
+// false, Get will return any arbitrary value.
 func (this InboxProperty) Get() vocab.OrderedCollectionInterface {
 	return this.OrderedCollectionMember
 }
 
+// GetAny returns whichever of Collection, CollectionPage, OrderedCollection,
+// or OrderedCollectionPage is set, boxed as the shared
+// vocab.CollectionKindInterface, or nil if none is set. This lets callers
+// holding only a vocab.InboxPropertyInterface reach the value generically
+// without switching on each concrete Is*/Get* pair themselves.
+func (this InboxProperty) GetAny() vocab.CollectionKindInterface {
+	if this.IsCollection() {
+		return this.GetCollection()
+	} else if this.IsCollectionPage() {
+		return this.GetCollectionPage()
+	} else if this.IsOrderedCollection() {
+		return this.Get()
+	} else if this.IsOrderedCollectionPage() {
+		return this.GetOrderedCollectionPage()
+	}
+	return nil
+}
+
+// GetCollection returns the value of this property. When IsCollection returns
+// false, GetCollection will return any arbitrary value.
+func (this InboxProperty) GetCollection() vocab.CollectionInterface {
+	return this.CollectionMember
+}
+
+// GetCollectionPage returns the value of this property. When IsCollectionPage
+// returns false, GetCollectionPage will return any arbitrary value.
+func (this InboxProperty) GetCollectionPage() vocab.CollectionPageInterface {
+	return this.CollectionPageMember
+}
+
 // GetIRI returns the IRI of this property. When IsIRI returns false, GetIRI will
 // return any arbitrary value.
 func (this InboxProperty) GetIRI() *url.URL {
 	return this.iri
 }
 
-// HasAny returns true if the value or IRI is set.
+// GetOrderedCollectionPage returns the value of this property. When
+// IsOrderedCollectionPage returns false, GetOrderedCollectionPage will return
+// any arbitrary value.
+func (this InboxProperty) GetOrderedCollectionPage() vocab.OrderedCollectionPageInterface {
+	return this.OrderedCollectionPageMember
+}
+
+// HasAny returns true if any of the values are set, or if the IRI is set.
 func (this InboxProperty) HasAny() bool {
-	return this.IsOrderedCollection() || this.iri != nil
+	return this.IsCollection() ||
+		this.IsCollectionPage() ||
+		this.IsOrderedCollection() ||
+		this.IsOrderedCollectionPage() ||
+		this.iri != nil
+}
+
+// IsCollection returns true if this property is set and not an IRI.
+func (this InboxProperty) IsCollection() bool {
+	return this.CollectionMember != nil
+}
+
+// IsCollectionPage returns true if this property is set and not an IRI.
+func (this InboxProperty) IsCollectionPage() bool {
+	return this.CollectionPageMember != nil
 }
 
 // IsIRI returns true if this property is an IRI.
@@ -98,14 +198,26 @@ func (this InboxProperty) IsOrderedCollection() bool {
 	return this.OrderedCollectionMember != nil
 }
 
+// IsOrderedCollectionPage returns true if this property is set and not an
+// IRI.
+func (this InboxProperty) IsOrderedCollectionPage() bool {
+	return this.OrderedCollectionPageMember != nil
+}
+
 // JSONLDContext returns the JSONLD URIs required in the context string for this
 // property and the specific values that are set. The value in the map is the
 // alias used to import the property's value or values.
 func (this InboxProperty) JSONLDContext() map[string]string {
 	m := map[string]string{"https://www.w3.org/TR/activitystreams-vocabulary": this.alias}
 	var child map[string]string
-	if this.IsOrderedCollection() {
+	if this.IsCollection() {
+		child = this.GetCollection().JSONLDContext()
+	} else if this.IsCollectionPage() {
+		child = this.GetCollectionPage().JSONLDContext()
+	} else if this.IsOrderedCollection() {
 		child = this.Get().JSONLDContext()
+	} else if this.IsOrderedCollectionPage() {
+		child = this.GetOrderedCollectionPage().JSONLDContext()
 	}
 	/*
 	   Since the literal maps in this function are determined at
@@ -122,9 +234,18 @@ func (this InboxProperty) JSONLDContext() map[string]string {
 // a leaky API detail only for folks looking to replace the go-fed
 // implementation. Applications should not use this method.
 func (this InboxProperty) KindIndex() int {
-	if this.IsOrderedCollection() {
+	if this.IsCollection() {
 		return 0
 	}
+	if this.IsCollectionPage() {
+		return 1
+	}
+	if this.IsOrderedCollection() {
+		return 2
+	}
+	if this.IsOrderedCollectionPage() {
+		return 3
+	}
 	if this.IsIRI() {
 		return -2
 	}
@@ -136,30 +257,29 @@ func (this InboxProperty) KindIndex() int {
 // help alternative implementations to go-fed to be able to normalize
 // nonfunctional properties.
 func (this InboxProperty) LessThan(o vocab.InboxPropertyInterface) bool {
-	// LessThan comparison for if either or both are IRIs.
-	if this.IsIRI() && o.IsIRI() {
-		return this.iri.String() < o.GetIRI().String()
-	} else if this.IsIRI() {
-		// IRIs are always less than other values, none, or unknowns
+	idx1 := this.KindIndex()
+	idx2 := o.KindIndex()
+	if idx1 < idx2 {
 		return true
-	} else if o.IsIRI() {
-		// This other, none, or unknown value is always greater than IRIs
+	} else if idx1 > idx2 {
 		return false
+	} else if this.IsIRI() {
+		// Both are IRIs; KindIndex has already ordered an IRI against any
+		// other kind.
+		return this.iri.String() < o.GetIRI().String()
 	}
 	// LessThan comparison for the single value or unknown value.
-	if !this.IsOrderedCollection() && !o.IsOrderedCollection() {
-		// Both are unknowns.
-		return false
-	} else if this.IsOrderedCollection() && !o.IsOrderedCollection() {
-		// Values are always greater than unknown values.
-		return false
-	} else if !this.IsOrderedCollection() && o.IsOrderedCollection() {
-		// Unknowns are always less than known values.
-		return true
-	} else {
-		// Actual comparison.
+	if this.IsCollection() {
+		return this.GetCollection().LessThan(o.GetCollection())
+	} else if this.IsCollectionPage() {
+		return this.GetCollectionPage().LessThan(o.GetCollectionPage())
+	} else if this.IsOrderedCollection() {
 		return this.Get().LessThan(o.Get())
+	} else if this.IsOrderedCollectionPage() {
+		return this.GetOrderedCollectionPage().LessThan(o.GetOrderedCollectionPage())
 	}
+	// Both are unknowns.
+	return false
 }
 
 // Name returns the name of this property: "inbox".
@@ -172,8 +292,14 @@ func (this InboxProperty) Name() string {
 // function as most typical use cases serialize types instead of individual
 // properties. It is exposed for alternatives to go-fed implementations to use.
 func (this InboxProperty) Serialize() (interface{}, error) {
-	if this.IsOrderedCollection() {
+	if this.IsCollection() {
+		return this.GetCollection().Serialize()
+	} else if this.IsCollectionPage() {
+		return this.GetCollectionPage().Serialize()
+	} else if this.IsOrderedCollection() {
 		return this.Get().Serialize()
+	} else if this.IsOrderedCollectionPage() {
+		return this.GetOrderedCollectionPage().Serialize()
 	} else if this.IsIRI() {
 		return this.iri.String(), nil
 	}
@@ -187,9 +313,30 @@ func (this *InboxProperty) Set(v vocab.OrderedCollectionInterface) {
 	this.OrderedCollectionMember = v
 }
 
+// SetCollection sets the value of this property. Calling IsCollection
+// afterwards will return true.
+func (this *InboxProperty) SetCollection(v vocab.CollectionInterface) {
+	this.Clear()
+	this.CollectionMember = v
+}
+
+// SetCollectionPage sets the value of this property. Calling IsCollectionPage
+// afterwards will return true.
+func (this *InboxProperty) SetCollectionPage(v vocab.CollectionPageInterface) {
+	this.Clear()
+	this.CollectionPageMember = v
+}
+
 // SetIRI sets the value of this property. Calling IsIRI afterwards will return
 // true.
 func (this *InboxProperty) SetIRI(v *url.URL) {
 	this.Clear()
 	this.iri = v
 }
+
+// SetOrderedCollectionPage sets the value of this property. Calling
+// IsOrderedCollectionPage afterwards will return true.
+func (this *InboxProperty) SetOrderedCollectionPage(v vocab.OrderedCollectionPageInterface) {
+	this.Clear()
+	this.OrderedCollectionPageMember = v
+}
@@ -0,0 +1,216 @@
+package propertyinbox
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	vocab "github.com/go-fed/activity/streams/vocab"
+)
+
+// The four Deserialize*ActivityStreams funcs this package calls are normally
+// wired up by a manager that the generator in tools/exp assembles from every
+// concrete ActivityStreams type (not present in this checkout -- see the
+// NOTE atop gen_property_inbox.go). The fakes and the manager stub below
+// stand in for that wiring so the per-kind branches of
+// DeserializeInboxProperty, and KindIndex/LessThan across kinds, can
+// actually be exercised here.
+
+var errNotThisKind = errors.New("not this kind")
+
+type fakeCollection struct{ id string }
+
+func (f fakeCollection) JSONLDContext() map[string]string { return map[string]string{"id": f.id} }
+func (f fakeCollection) Serialize() (interface{}, error)  { return f.id, nil }
+func (f fakeCollection) LessThan(o vocab.CollectionInterface) bool {
+	return f.id < o.(fakeCollection).id
+}
+
+type fakeCollectionPage struct{ id string }
+
+func (f fakeCollectionPage) JSONLDContext() map[string]string { return map[string]string{"id": f.id} }
+func (f fakeCollectionPage) Serialize() (interface{}, error)  { return f.id, nil }
+func (f fakeCollectionPage) LessThan(o vocab.CollectionPageInterface) bool {
+	return f.id < o.(fakeCollectionPage).id
+}
+
+type fakeOrderedCollection struct{ id string }
+
+func (f fakeOrderedCollection) JSONLDContext() map[string]string {
+	return map[string]string{"id": f.id}
+}
+func (f fakeOrderedCollection) Serialize() (interface{}, error) { return f.id, nil }
+func (f fakeOrderedCollection) LessThan(o vocab.OrderedCollectionInterface) bool {
+	return f.id < o.(fakeOrderedCollection).id
+}
+
+type fakeOrderedCollectionPage struct{ id string }
+
+func (f fakeOrderedCollectionPage) JSONLDContext() map[string]string {
+	return map[string]string{"id": f.id}
+}
+func (f fakeOrderedCollectionPage) Serialize() (interface{}, error) { return f.id, nil }
+func (f fakeOrderedCollectionPage) LessThan(o vocab.OrderedCollectionPageInterface) bool {
+	return f.id < o.(fakeOrderedCollectionPage).id
+}
+
+// testManager is a test-only stand-in for the manager that gen_property_inbox.go
+// expects to find as the package-level mgr variable, discriminating by the
+// JSON-LD "type" value the way the real generated managers do.
+type testManager struct{}
+
+func (testManager) DeserializeCollectionActivityStreams() func(map[string]interface{}, map[string]string) (vocab.CollectionInterface, error) {
+	return func(m map[string]interface{}, _ map[string]string) (vocab.CollectionInterface, error) {
+		if t, _ := m["type"].(string); t != "Collection" {
+			return nil, errNotThisKind
+		}
+		id, _ := m["id"].(string)
+		return fakeCollection{id: id}, nil
+	}
+}
+
+func (testManager) DeserializeCollectionPageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.CollectionPageInterface, error) {
+	return func(m map[string]interface{}, _ map[string]string) (vocab.CollectionPageInterface, error) {
+		if t, _ := m["type"].(string); t != "CollectionPage" {
+			return nil, errNotThisKind
+		}
+		id, _ := m["id"].(string)
+		return fakeCollectionPage{id: id}, nil
+	}
+}
+
+func (testManager) DeserializeOrderedCollectionActivityStreams() func(map[string]interface{}, map[string]string) (vocab.OrderedCollectionInterface, error) {
+	return func(m map[string]interface{}, _ map[string]string) (vocab.OrderedCollectionInterface, error) {
+		if t, _ := m["type"].(string); t != "OrderedCollection" {
+			return nil, errNotThisKind
+		}
+		id, _ := m["id"].(string)
+		return fakeOrderedCollection{id: id}, nil
+	}
+}
+
+func (testManager) DeserializeOrderedCollectionPageActivityStreams() func(map[string]interface{}, map[string]string) (vocab.OrderedCollectionPageInterface, error) {
+	return func(m map[string]interface{}, _ map[string]string) (vocab.OrderedCollectionPageInterface, error) {
+		if t, _ := m["type"].(string); t != "OrderedCollectionPage" {
+			return nil, errNotThisKind
+		}
+		id, _ := m["id"].(string)
+		return fakeOrderedCollectionPage{id: id}, nil
+	}
+}
+
+// mgr satisfies the package-level mgr variable that DeserializeInboxProperty
+// calls through. It is not defined anywhere else in this checkout; see the
+// NOTE atop gen_property_inbox.go.
+var mgr = testManager{}
+
+func TestDeserializeInboxPropertyKinds(t *testing.T) {
+	tests := []struct {
+		name   string
+		m      map[string]interface{}
+		wantID string
+		check  func(p *InboxProperty) bool
+		getID  func(p *InboxProperty) string
+	}{
+		{
+			name:   "Collection",
+			m:      map[string]interface{}{"type": "Collection", "id": "a"},
+			wantID: "a",
+			check:  (*InboxProperty).IsCollection,
+			getID:  func(p *InboxProperty) string { return p.GetCollection().(fakeCollection).id },
+		},
+		{
+			name:   "CollectionPage",
+			m:      map[string]interface{}{"type": "CollectionPage", "id": "b"},
+			wantID: "b",
+			check:  (*InboxProperty).IsCollectionPage,
+			getID:  func(p *InboxProperty) string { return p.GetCollectionPage().(fakeCollectionPage).id },
+		},
+		{
+			name:   "OrderedCollection",
+			m:      map[string]interface{}{"type": "OrderedCollection", "id": "c"},
+			wantID: "c",
+			check:  (*InboxProperty).IsOrderedCollection,
+			getID:  func(p *InboxProperty) string { return p.Get().(fakeOrderedCollection).id },
+		},
+		{
+			name:   "OrderedCollectionPage",
+			m:      map[string]interface{}{"type": "OrderedCollectionPage", "id": "d"},
+			wantID: "d",
+			check:  (*InboxProperty).IsOrderedCollectionPage,
+			getID:  func(p *InboxProperty) string { return p.GetOrderedCollectionPage().(fakeOrderedCollectionPage).id },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := DeserializeInboxProperty(map[string]interface{}{"inbox": test.m}, nil)
+			if err != nil {
+				t.Fatalf("DeserializeInboxProperty() error = %v", err)
+			}
+			if p == nil {
+				t.Fatalf("DeserializeInboxProperty() = nil")
+			}
+			if !test.check(p) {
+				t.Fatalf("Is%s() = false, want true", test.name)
+			}
+			if got := test.getID(p); got != test.wantID {
+				t.Fatalf("got id %q, want %q", got, test.wantID)
+			}
+			if got := p.GetAny(); got == nil {
+				t.Fatalf("GetAny() = nil, want the %s value", test.name)
+			}
+		})
+	}
+}
+
+func TestInboxPropertyKindIndexOrdering(t *testing.T) {
+	iri := NewInboxProperty()
+	u, err := url.Parse("https://example.com/inbox")
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	iri.SetIRI(u)
+
+	unknown := NewInboxProperty()
+
+	collection := NewInboxProperty()
+	collection.SetCollection(fakeCollection{id: "c"})
+
+	collectionPage := NewInboxProperty()
+	collectionPage.SetCollectionPage(fakeCollectionPage{id: "cp"})
+
+	orderedCollection := NewInboxProperty()
+	orderedCollection.Set(fakeOrderedCollection{id: "oc"})
+
+	orderedCollectionPage := NewInboxProperty()
+	orderedCollectionPage.SetOrderedCollectionPage(fakeOrderedCollectionPage{id: "ocp"})
+
+	// Ascending by KindIndex: IRI(-2) < unknown(-1) < Collection(0) <
+	// CollectionPage(1) < OrderedCollection(2) < OrderedCollectionPage(3).
+	inAscendingOrder := []*InboxProperty{iri, unknown, collection, collectionPage, orderedCollection, orderedCollectionPage}
+	for i := 0; i < len(inAscendingOrder)-1; i++ {
+		lo, hi := inAscendingOrder[i], inAscendingOrder[i+1]
+		if lo.KindIndex() >= hi.KindIndex() {
+			t.Fatalf("KindIndex() not strictly increasing at position %d: %d >= %d", i, lo.KindIndex(), hi.KindIndex())
+		}
+		if !lo.LessThan(hi) {
+			t.Fatalf("LessThan() = false at position %d, want true (KindIndex %d < %d)", i, lo.KindIndex(), hi.KindIndex())
+		}
+		if hi.LessThan(lo) {
+			t.Fatalf("LessThan() = true in reverse at position %d, want false", i)
+		}
+	}
+}
+
+func TestInboxPropertyLessThanSameKind(t *testing.T) {
+	a := NewInboxProperty()
+	a.SetCollection(fakeCollection{id: "a"})
+	b := NewInboxProperty()
+	b.SetCollection(fakeCollection{id: "b"})
+	if !a.LessThan(b) {
+		t.Fatalf("LessThan() = false, want true: same-kind comparison should delegate to the concrete value's LessThan")
+	}
+	if b.LessThan(a) {
+		t.Fatalf("LessThan() = true in reverse, want false")
+	}
+}
@@ -0,0 +1,96 @@
+package propertyinbox
+
+import "testing"
+
+func TestDeserializeInboxPropertyIRI(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        map[string]interface{}
+		aliasMap map[string]string
+		wantIRI  string
+	}{
+		{
+			name:    "bare string IRI, unaliased",
+			m:       map[string]interface{}{"inbox": "https://example.com/inbox"},
+			wantIRI: "https://example.com/inbox",
+		},
+		{
+			name: "bare string IRI, aliased",
+			m:    map[string]interface{}{"as:inbox": "https://example.com/inbox"},
+			aliasMap: map[string]string{
+				"https://www.w3.org/TR/activitystreams-vocabulary": "as",
+			},
+			wantIRI: "https://example.com/inbox",
+		},
+		{
+			name: "JSON-LD node object with @id, unaliased",
+			m: map[string]interface{}{
+				"inbox": map[string]interface{}{"@id": "https://example.com/inbox"},
+			},
+			wantIRI: "https://example.com/inbox",
+		},
+		{
+			name: "JSON-LD node object with @id, aliased",
+			m: map[string]interface{}{
+				"as:inbox": map[string]interface{}{"@id": "https://example.com/inbox"},
+			},
+			aliasMap: map[string]string{
+				"https://www.w3.org/TR/activitystreams-vocabulary": "as",
+			},
+			wantIRI: "https://example.com/inbox",
+		},
+		{
+			name: "JSON-LD node object with keyword-aliased id",
+			m: map[string]interface{}{
+				"inbox": map[string]interface{}{"id": "https://example.com/inbox"},
+			},
+			wantIRI: "https://example.com/inbox",
+		},
+		{
+			name: "JSON-LD node object with @id and Link type",
+			m: map[string]interface{}{
+				"inbox": map[string]interface{}{
+					"@id":  "https://example.com/inbox",
+					"type": "Link",
+				},
+			},
+			wantIRI: "https://example.com/inbox",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := DeserializeInboxProperty(test.m, test.aliasMap)
+			if err != nil {
+				t.Fatalf("DeserializeInboxProperty() error = %v", err)
+			}
+			if p == nil {
+				t.Fatalf("DeserializeInboxProperty() = nil")
+			}
+			if !p.IsIRI() {
+				t.Fatalf("IsIRI() = false, want true")
+			}
+			if got := p.GetIRI().String(); got != test.wantIRI {
+				t.Fatalf("GetIRI() = %q, want %q", got, test.wantIRI)
+			}
+		})
+	}
+}
+
+func TestDeserializeInboxPropertyRejectsExtraKeys(t *testing.T) {
+	m := map[string]interface{}{
+		"inbox": map[string]interface{}{
+			"@id":   "https://example.com/inbox",
+			"extra": "key",
+		},
+	}
+	p, err := DeserializeInboxProperty(m, nil)
+	if err != nil {
+		t.Fatalf("DeserializeInboxProperty() error = %v", err)
+	}
+	if p == nil {
+		t.Fatalf("DeserializeInboxProperty() = nil")
+	}
+	if p.IsIRI() {
+		t.Fatalf("IsIRI() = true, want false: a node object with extra keys is not a bare IRI reference")
+	}
+}
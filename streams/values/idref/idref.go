@@ -0,0 +1,48 @@
+// Package idref detects the JSON-LD node-object form of an IRI reference, so
+// that property deserializers can accept it alongside a bare IRI string.
+package idref
+
+import "net/url"
+
+// Resolve detects a JSON-LD node object that does nothing more than
+// reference an IRI -- a bare {"@id": "..."}, its "id" keyword-aliased form,
+// or either additionally decorated with a Link type -- and returns the
+// parsed IRI.
+func Resolve(m map[string]interface{}) (*url.URL, bool) {
+	idKeys := []string{"@id", "id"}
+	var idStr string
+	var found bool
+	for _, k := range idKeys {
+		if raw, ok := m[k]; ok {
+			if s, ok := raw.(string); ok {
+				idStr = s
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		return nil, false
+	}
+	isIdKey := make(map[string]bool, len(idKeys))
+	for _, k := range idKeys {
+		isIdKey[k] = true
+	}
+	for k, v := range m {
+		if isIdKey[k] {
+			continue
+		}
+		if k == "@type" || k == "type" {
+			if s, ok := v.(string); ok && s == "Link" {
+				continue
+			}
+		}
+		// Any other key means this is more than a bare IRI reference.
+		return nil, false
+	}
+	u, err := url.Parse(idStr)
+	if err != nil || len(u.Scheme) == 0 {
+		return nil, false
+	}
+	return u, true
+}
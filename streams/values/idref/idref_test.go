@@ -0,0 +1,74 @@
+package idref
+
+import "testing"
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name    string
+		m       map[string]interface{}
+		wantIRI string
+		wantOK  bool
+	}{
+		{
+			name:    "@id keyword",
+			m:       map[string]interface{}{"@id": "https://example.com/thing"},
+			wantIRI: "https://example.com/thing",
+			wantOK:  true,
+		},
+		{
+			name:    "id keyword alias",
+			m:       map[string]interface{}{"id": "https://example.com/thing"},
+			wantIRI: "https://example.com/thing",
+			wantOK:  true,
+		},
+		{
+			name: "@id with Link type",
+			m: map[string]interface{}{
+				"@id":  "https://example.com/thing",
+				"type": "Link",
+			},
+			wantIRI: "https://example.com/thing",
+			wantOK:  true,
+		},
+		{
+			name: "@id with non-Link type is rejected",
+			m: map[string]interface{}{
+				"@id":  "https://example.com/thing",
+				"type": "Note",
+			},
+			wantOK: false,
+		},
+		{
+			name: "extra key is rejected",
+			m: map[string]interface{}{
+				"@id":   "https://example.com/thing",
+				"extra": "key",
+			},
+			wantOK: false,
+		},
+		{
+			name:   "no id key present",
+			m:      map[string]interface{}{"type": "Link"},
+			wantOK: false,
+		},
+		{
+			name:   "id value has no scheme",
+			m:      map[string]interface{}{"@id": "not-a-url"},
+			wantOK: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, ok := Resolve(test.m)
+			if ok != test.wantOK {
+				t.Fatalf("Resolve() ok = %v, want %v", ok, test.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got := u.String(); got != test.wantIRI {
+				t.Fatalf("Resolve() = %q, want %q", got, test.wantIRI)
+			}
+		})
+	}
+}
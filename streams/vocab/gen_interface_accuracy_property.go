@@ -0,0 +1,71 @@
+package vocab
+
+import "net/url"
+
+// AccuracyPropertyInterface represents the "accuracy" property. All
+// implementations are guaranteed to store values permitted by the
+// ActivityStreams 2.0 Vocabulary, which constrains "accuracy" to a float64
+// in the closed interval [0.0, 100.0].
+//
+// Note: This is a convenience interface for reducing boilerplate code when
+// an application works with properties whose values are directly
+// convertible to general Go types or builtin types. Developers can cast
+// values with this generic property interface to a type-specific interface
+// as needed. But developers should not attempt to maintain references to
+// this generic property interface as the underlying implementation is
+// subject to change. Instead, references should be kept to the
+// implementation of this interface, if additional functionality is needed
+// from this type.
+type AccuracyPropertyInterface interface {
+	// Clear ensures no value of this property is set. Calling IsFloat
+	// afterwards will return false.
+	Clear()
+	// Get returns the value of this property. When IsFloat returns false,
+	// Get will return any arbitrary value.
+	Get() float64
+	// GetIRI returns the IRI of this property. When IsIRI returns false,
+	// GetIRI will return any arbitrary value.
+	GetIRI() *url.URL
+	// HasAny returns true if the value or IRI is set.
+	HasAny() bool
+	// IsFloat returns true if this property is set and not an IRI.
+	IsFloat() bool
+	// IsIRI returns true if this property is an IRI.
+	IsIRI() bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of
+	// value. This is a leaky API detail only for folks looking to replace
+	// the go-fed implementation. Applications should not use this method.
+	KindIndex() int
+	// LessThan compares two instances of this property with an arbitrary
+	// but stable comparison. Applications should not use this because it
+	// is only meant to help alternative implementations to go-fed be able
+	// to normalize nonfunctional properties.
+	LessThan(o AccuracyPropertyInterface) bool
+	// MustSet sets the value of this property, panicking if v falls
+	// outside of the AS2 accuracy range. Callers that have already
+	// validated v may prefer this over handling the error from Set.
+	MustSet(v float64)
+	// Name returns the name of this property: "accuracy".
+	Name() string
+	// Serialize converts this into an interface representation suitable
+	// for marshalling into a text or binary format. Applications should
+	// not need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// Set sets the value of this property. Calling IsFloat afterwards
+	// will return true. Returns an error if v falls outside of the AS2
+	// accuracy range, leaving the property unchanged.
+	Set(v float64) error
+	// SetClamped sets the value of this property, clamping v into the AS2
+	// accuracy range first. Calling IsFloat afterwards will return true.
+	SetClamped(v float64)
+	// SetIRI sets the value of this property. Calling IsIRI afterwards
+	// will return true.
+	SetIRI(v *url.URL)
+}
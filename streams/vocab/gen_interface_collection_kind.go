@@ -0,0 +1,18 @@
+package vocab
+
+// CollectionKindInterface is a narrow interface satisfied by any of
+// CollectionInterface, CollectionPageInterface, OrderedCollectionInterface,
+// and OrderedCollectionPageInterface. It lets properties that accept any of
+// these interchangeably, such as "inbox", expose the value they hold to
+// callers that only need to inspect its JSON-LD context or serialize it,
+// without those callers having to know which concrete kind is actually set.
+type CollectionKindInterface interface {
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this value and the specific properties that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// Serialize converts this into an interface representation suitable
+	// for marshalling into a text or binary format.
+	Serialize() (interface{}, error)
+}
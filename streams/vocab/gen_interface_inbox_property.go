@@ -0,0 +1,97 @@
+package vocab
+
+import "net/url"
+
+// InboxPropertyInterface represents the "inbox" property. It is permitted to
+// hold a Collection, CollectionPage, OrderedCollection, or
+// OrderedCollectionPage value, since peers commonly inline a page of their
+// inbox rather than its root OrderedCollection.
+//
+// Note: This is a convenience interface for reducing boilerplate code when
+// an application works with properties whose values are directly
+// convertible to general Go types or builtin types. Developers can cast
+// values with this generic property interface to a type-specific interface
+// as needed. But developers should not attempt to maintain references to
+// this generic property interface as the underlying implementation is
+// subject to change. Instead, references should be kept to the
+// implementation of this interface, if additional functionality is needed
+// from this type.
+type InboxPropertyInterface interface {
+	// Clear ensures no value of this property is set. Calling HasAny
+	// afterwards will return false.
+	Clear()
+	// Get returns the value of this property. When IsOrderedCollection
+	// returns false, Get will return any arbitrary value.
+	Get() OrderedCollectionInterface
+	// GetAny returns whichever of Collection, CollectionPage,
+	// OrderedCollection, or OrderedCollectionPage is set, boxed as the
+	// shared CollectionKindInterface, or nil if none is set.
+	GetAny() CollectionKindInterface
+	// GetCollection returns the value of this property. When IsCollection
+	// returns false, GetCollection will return any arbitrary value.
+	GetCollection() CollectionInterface
+	// GetCollectionPage returns the value of this property. When
+	// IsCollectionPage returns false, GetCollectionPage will return any
+	// arbitrary value.
+	GetCollectionPage() CollectionPageInterface
+	// GetIRI returns the IRI of this property. When IsIRI returns false,
+	// GetIRI will return any arbitrary value.
+	GetIRI() *url.URL
+	// GetOrderedCollectionPage returns the value of this property. When
+	// IsOrderedCollectionPage returns false, GetOrderedCollectionPage
+	// will return any arbitrary value.
+	GetOrderedCollectionPage() OrderedCollectionPageInterface
+	// HasAny returns true if any of the values are set, or if the IRI is
+	// set.
+	HasAny() bool
+	// IsCollection returns true if this property is set and not an IRI.
+	IsCollection() bool
+	// IsCollectionPage returns true if this property is set and not an
+	// IRI.
+	IsCollectionPage() bool
+	// IsIRI returns true if this property is an IRI.
+	IsIRI() bool
+	// IsOrderedCollection returns true if this property is set and not an
+	// IRI.
+	IsOrderedCollection() bool
+	// IsOrderedCollectionPage returns true if this property is set and
+	// not an IRI.
+	IsOrderedCollectionPage() bool
+	// JSONLDContext returns the JSONLD URIs required in the context string
+	// for this property and the specific values that are set. The value
+	// in the map is the alias used to import the property's value or
+	// values.
+	JSONLDContext() map[string]string
+	// KindIndex computes an arbitrary value for indexing this kind of
+	// value. This is a leaky API detail only for folks looking to replace
+	// the go-fed implementation. Applications should not use this method.
+	KindIndex() int
+	// LessThan compares two instances of this property with an arbitrary
+	// but stable comparison. Applications should not use this because it
+	// is only meant to help alternative implementations to go-fed be able
+	// to normalize nonfunctional properties.
+	LessThan(o InboxPropertyInterface) bool
+	// Name returns the name of this property: "inbox".
+	Name() string
+	// Serialize converts this into an interface representation suitable
+	// for marshalling into a text or binary format. Applications should
+	// not need this function as most typical use cases serialize types
+	// instead of individual properties. It is exposed for alternatives to
+	// go-fed implementations to use.
+	Serialize() (interface{}, error)
+	// Set sets the value of this property. Calling IsOrderedCollection
+	// afterwards will return true.
+	Set(v OrderedCollectionInterface)
+	// SetCollection sets the value of this property. Calling IsCollection
+	// afterwards will return true.
+	SetCollection(v CollectionInterface)
+	// SetCollectionPage sets the value of this property. Calling
+	// IsCollectionPage afterwards will return true.
+	SetCollectionPage(v CollectionPageInterface)
+	// SetIRI sets the value of this property. Calling IsIRI afterwards
+	// will return true.
+	SetIRI(v *url.URL)
+	// SetOrderedCollectionPage sets the value of this property. Calling
+	// IsOrderedCollectionPage afterwards will return true.
+	SetOrderedCollectionPage(v OrderedCollectionPageInterface)
+}